@@ -0,0 +1,105 @@
+package runner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// snapshotDiff is the set of changes between two enumeration
+// snapshots, computed by diffSnapshots.
+type snapshotDiff struct {
+	Added   []*schema.Resource
+	Removed []*schema.Resource
+	Changed []resourceChange
+}
+
+// resourceChange is a resource whose identity (provider + DNS name or
+// ID) is present in both snapshots but whose content hash differs,
+// e.g. an A-record whose target IP moved.
+type resourceChange struct {
+	Key    string
+	Before *schema.Resource
+	After  *schema.Resource
+}
+
+// IsEmpty reports whether the diff carries no changes.
+func (d *snapshotDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String formats the diff as a human readable summary suitable for
+// posting to a notify.Sink.
+func (d *snapshotDiff) String() string {
+	var b strings.Builder
+	for _, resource := range d.Added {
+		fmt.Fprintf(&b, "+ %s\n", resource.String())
+	}
+	for _, resource := range d.Removed {
+		fmt.Fprintf(&b, "- %s\n", resource.String())
+	}
+	for _, change := range d.Changed {
+		fmt.Fprintf(&b, "~ %s: %s -> %s\n", change.Key, change.Before.String(), change.After.String())
+	}
+	return b.String()
+}
+
+// diffSnapshots computes the delta between a previous and current
+// enumeration snapshot. previous may be nil, in which case every
+// current resource is reported as added. A current resource counts as
+// changed only when no previous resource has its content hash, so
+// several resources sharing one identity (e.g. a NodePort service on
+// every node IP) are not reported as changed because of their order.
+func diffSnapshots(previous, current *schema.Resources) *snapshotDiff {
+	diff := &snapshotDiff{}
+
+	previousByKey := map[string]*schema.Resource{}
+	previousHashes := map[string]bool{}
+	if previous != nil {
+		for _, resource := range previous.Items {
+			previousByKey[resourceIdentity(resource)] = resource
+			previousHashes[resource.ContentHash()] = true
+		}
+	}
+
+	currentByKey := map[string]*schema.Resource{}
+	for _, resource := range current.Items {
+		key := resourceIdentity(resource)
+		currentByKey[key] = resource
+
+		before, existed := previousByKey[key]
+		switch {
+		case !existed:
+			diff.Added = append(diff.Added, resource)
+		case !previousHashes[resource.ContentHash()]:
+			diff.Changed = append(diff.Changed, resourceChange{Key: key, Before: before, After: resource})
+		}
+	}
+
+	for key, resource := range previousByKey {
+		if _, stillPresent := currentByKey[key]; !stillPresent {
+			diff.Removed = append(diff.Removed, resource)
+		}
+	}
+	return diff
+}
+
+// resourceIdentity returns the stable key used to match a resource
+// across runs. For a resource with a DNS name or ID it excludes anything
+// that can legitimately change between runs (IPs, DNS targets) so that
+// such changes surface as a "changed" entry instead of a remove+add
+// pair; a resource with neither is keyed by its IPs, so an IP change
+// there shows up as a remove+add pair. Profile is always
+// included so two accounts/profiles configured for the same provider
+// never collide on the same DNS name, ID or IP.
+func resourceIdentity(resource *schema.Resource) string {
+	switch {
+	case resource.DNSName != "":
+		return resource.Provider + "|" + resource.Profile + "|dns|" + resource.DNSName
+	case resource.ID != "":
+		return resource.Provider + "|" + resource.Profile + "|id|" + resource.ID
+	default:
+		return resource.Provider + "|" + resource.Profile + "|ip|" + resource.PublicIPv4 + "|" + resource.PublicIPv6
+	}
+}
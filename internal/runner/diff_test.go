@@ -0,0 +1,129 @@
+package runner
+
+import (
+	"testing"
+
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+func TestResourceIdentity(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource *schema.Resource
+		want     string
+	}{
+		{
+			name:     "dns name takes priority",
+			resource: &schema.Resource{Provider: "aws", Profile: "prod", DNSName: "api.example.com", PublicIPv4: "1.1.1.1"},
+			want:     "aws|prod|dns|api.example.com",
+		},
+		{
+			name:     "id used when no dns name",
+			resource: &schema.Resource{Provider: "kubernetes", Profile: "staging", ID: "default/svc:30001"},
+			want:     "kubernetes|staging|id|default/svc:30001",
+		},
+		{
+			name:     "falls back to ip pair",
+			resource: &schema.Resource{Provider: "azure", Profile: "prod", PublicIPv4: "2.2.2.2", PublicIPv6: "::1"},
+			want:     "azure|prod|ip|2.2.2.2|::1",
+		},
+		{
+			name:     "distinct profiles on the same provider and dns name never collide",
+			resource: &schema.Resource{Provider: "aws", Profile: "account-b", DNSName: "api.example.com"},
+			want:     "aws|account-b|dns|api.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resourceIdentity(tt.resource); got != tt.want {
+				t.Errorf("resourceIdentity() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	apiProd := func(ip string) *schema.Resource {
+		return &schema.Resource{Provider: "aws", Profile: "prod", DNSName: "api.example.com", PublicIPv4: ip, Public: true}
+	}
+
+	tests := []struct {
+		name        string
+		previous    *schema.Resources
+		current     *schema.Resources
+		wantAdded   int
+		wantRemoved int
+		wantChanged int
+	}{
+		{
+			name:      "nil previous reports every resource as added",
+			previous:  nil,
+			current:   &schema.Resources{Items: []*schema.Resource{apiProd("1.1.1.1")}},
+			wantAdded: 1,
+		},
+		{
+			name:        "identical snapshots produce no diff",
+			previous:    &schema.Resources{Items: []*schema.Resource{apiProd("1.1.1.1")}},
+			current:     &schema.Resources{Items: []*schema.Resource{apiProd("1.1.1.1")}},
+			wantAdded:   0,
+			wantRemoved: 0,
+			wantChanged: 0,
+		},
+		{
+			name:      "new resource is added",
+			previous:  &schema.Resources{},
+			current:   &schema.Resources{Items: []*schema.Resource{apiProd("1.1.1.1")}},
+			wantAdded: 1,
+		},
+		{
+			name:        "missing resource is removed",
+			previous:    &schema.Resources{Items: []*schema.Resource{apiProd("1.1.1.1")}},
+			current:     &schema.Resources{},
+			wantRemoved: 1,
+		},
+		{
+			name:        "same identity with a different target is changed, not removed+added",
+			previous:    &schema.Resources{Items: []*schema.Resource{apiProd("1.1.1.1")}},
+			current:     &schema.Resources{Items: []*schema.Resource{apiProd("9.9.9.9")}},
+			wantChanged: 1,
+		},
+		{
+			name: "a different profile with the same dns name does not mask the real change",
+			previous: &schema.Resources{Items: []*schema.Resource{
+				apiProd("1.1.1.1"),
+				{Provider: "aws", Profile: "staging", DNSName: "api.example.com", PublicIPv4: "5.5.5.5"},
+			}},
+			current: &schema.Resources{Items: []*schema.Resource{
+				apiProd("1.1.1.1"),
+				{Provider: "aws", Profile: "staging", DNSName: "api.example.com", PublicIPv4: "6.6.6.6"},
+			}},
+			wantChanged: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := diffSnapshots(tt.previous, tt.current)
+			if len(diff.Added) != tt.wantAdded {
+				t.Errorf("Added = %d, want %d", len(diff.Added), tt.wantAdded)
+			}
+			if len(diff.Removed) != tt.wantRemoved {
+				t.Errorf("Removed = %d, want %d", len(diff.Removed), tt.wantRemoved)
+			}
+			if len(diff.Changed) != tt.wantChanged {
+				t.Errorf("Changed = %d, want %d", len(diff.Changed), tt.wantChanged)
+			}
+		})
+	}
+}
+
+func TestSnapshotDiffIsEmpty(t *testing.T) {
+	if !(&snapshotDiff{}).IsEmpty() {
+		t.Error("zero-value diff should be empty")
+	}
+	nonEmpty := &snapshotDiff{Added: []*schema.Resource{{Provider: "aws"}}}
+	if nonEmpty.IsEmpty() {
+		t.Error("diff with an added resource should not be empty")
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"os"
 	"os/user"
 	"path"
+	"strings"
+	"time"
 
 	"github.com/projectdiscovery/cloudlist/pkg/schema"
 	"github.com/projectdiscovery/gologger"
@@ -25,6 +27,25 @@ type Options struct {
 	Config    string // Config is the location of the config file.
 	Output    string // Output is the file to write found results too.
 	Provider  string // Provider specifies what providers to fetch assets for.
+
+	Serve    bool          // Serve keeps the process alive and serves the last enumeration snapshot over HTTP.
+	Listen   string        // Listen is the host:port the HTTP server binds to when Serve is set.
+	Interval time.Duration // Interval is how often enumeration is re-run while serving.
+
+	State  string       // State is the path to the persisted snapshot used to diff successive runs.
+	Notify notifyTargets // Notify is the list of sinks (e.g. slack:<webhook>) to report diffs to.
+}
+
+// notifyTargets collects repeated -notify flags into a slice.
+type notifyTargets []string
+
+func (n *notifyTargets) String() string {
+	return strings.Join(*n, ",")
+}
+
+func (n *notifyTargets) Set(value string) error {
+	*n = append(*n, value)
+	return nil
 }
 
 var defaultConfigLocation = path.Join(userHomeDir(), "/.config/cloudlist/config.yaml")
@@ -42,6 +63,11 @@ func ParseOptions() *Options {
 	flag.StringVar(&options.Config, "config", defaultConfigLocation, "Configuration file to use for enumeration")
 	flag.StringVar(&options.Output, "o", "", "File to write output to (optional)")
 	flag.StringVar(&options.Provider, "provider", "", "Provider to fetch assets from (optional)")
+	flag.BoolVar(&options.Serve, "serve", false, "Run as a long-running server instead of a single enumeration")
+	flag.StringVar(&options.Listen, "listen", ":8080", "Address to listen on when running with -serve")
+	flag.DurationVar(&options.Interval, "interval", 15*time.Minute, "Interval to re-run enumeration when running with -serve")
+	flag.StringVar(&options.State, "state", "", "File to persist the enumeration snapshot to, for diffing across runs (optional)")
+	flag.Var(&options.Notify, "notify", "Sink to report diffs to, e.g. slack:<webhook>, discord:<webhook> or webhook:<url> (can be repeated)")
 	flag.Parse()
 
 	options.configureOutput()
@@ -127,6 +153,64 @@ const defaultConfigFile = `# Configuration file for cloudlist enumeration agent
 #  # aws_access_key is the access key for AWS account
 #  aws_access_key: AKIAXXXXXXXXXXXXXX
 #  # aws_secret_key is the secret key for AWS account
-#  aws_secret_key: xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx`
+#  aws_secret_key: xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
 #  # aws_session_token session token for temporary security credentials retrieved via STS (optional)
 #  aws_session_token: xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+#  # aws_assume_role_arn is a role to assume on top of the resolved credentials (optional)
+#  aws_assume_role_arn: arn:aws:iam::123456789012:role/cloudlist-readonly
+#
+#- # provider is the name of the provider
+#  provider: azure
+#  # profile is the name of the provider profile
+#  profile: staging
+#  # azure_subscription_id is the subscription to enumerate resources in
+#  azure_subscription_id: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+#  # azure_tenant_id is the AAD tenant of the service principal (optional, falls back to DefaultAzureCredential)
+#  azure_tenant_id: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+#  # azure_client_id is the application id of the service principal (optional)
+#  azure_client_id: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+#  # azure_client_secret is the secret of the service principal (optional)
+#  azure_client_secret: xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+#
+#- # provider is the name of the provider
+#  provider: kubernetes
+#  # profile is the name of the provider profile
+#  profile: staging
+#  # kubernetes_kubeconfig is the path to the kubeconfig file (optional, falls back to in-cluster config)
+#  kubernetes_kubeconfig: /home/user/.kube/config
+#
+#- # provider is the name of the provider
+#  provider: ibm
+#  # profile is the name of the provider profile
+#  profile: staging
+#  # ibm_api_key is the IAM API key for the IBM Cloud account
+#  ibm_api_key: xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+#  # ibm_region is the VPC region to enumerate (optional, defaults to us-south)
+#  ibm_region: us-south
+#  # ibm_dns_instance_id is the IBM Cloud DNS Services instance id (optional)
+#  ibm_dns_instance_id: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+#  # ibm_classic_username and ibm_classic_api_key authenticate against
+#  # Classic Infrastructure (SoftLayer), separate from ibm_api_key above.
+#  # Classic infrastructure hosts are skipped if these are not set.
+#  ibm_classic_username: xxxxx
+#  ibm_classic_api_key: xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+#
+#- # provider is the name of the provider
+#  provider: oci
+#  # profile is the name of the provider profile
+#  profile: staging
+#  # oci_compartment_id is the compartment to enumerate resources in
+#  oci_compartment_id: ocid1.compartment.oc1..xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+#  # oci_config_profile is the profile name in ~/.oci/config (optional)
+#  oci_config_profile: DEFAULT
+#
+#- # provider is the name of the provider
+#  provider: alibaba
+#  # profile is the name of the provider profile
+#  profile: staging
+#  # alibaba_access_key is the access key for the Alibaba Cloud account
+#  alibaba_access_key: xxxxxxxxxxxxxxxxxxxx
+#  # alibaba_access_key_secret is the access key secret for the Alibaba Cloud account
+#  alibaba_access_key_secret: xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+#  # alibaba_region is the region to enumerate (optional, defaults to cn-hangzhou)
+#  alibaba_region: cn-hangzhou`
@@ -0,0 +1,201 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mishra321shu/cloudlist/pkg/inventory"
+	"github.com/mishra321shu/cloudlist/pkg/notify"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+	"github.com/projectdiscovery/gologger"
+)
+
+// Runner is an instance of the cloudlist enumeration client used to
+// orchestrate the whole process.
+type Runner struct {
+	options   *Options
+	inventory *inventory.Inventory
+
+	// snapshotMu guards the fields populated by the last enumeration
+	// pass. It is only contended when running with -serve, where a
+	// background re-enumeration can overlap with an incoming HTTP
+	// request.
+	snapshotMu      sync.RWMutex
+	snapshot        *schema.Resources
+	lastRunAt       time.Time
+	lastRunDuration time.Duration
+	lastRunErr      error
+}
+
+// New creates a new runner struct instance for execution
+func New(options *Options) (*Runner, error) {
+	config, err := readConfig(options.Config)
+	if err != nil {
+		return nil, err
+	}
+	store, err := inventory.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Runner{options: options, inventory: store}, nil
+}
+
+// Enumerate runs a single enumeration pass across every configured
+// provider and writes the results to the configured output. If the
+// runner was started with -serve, it instead blocks serving the
+// periodically refreshed snapshot over HTTP.
+func (r *Runner) Enumerate() {
+	if r.options.Serve {
+		r.serve()
+		return
+	}
+
+	resources := r.runOnce(context.Background())
+
+	writer := os.Stdout
+	if r.options.Output != "" {
+		file, err := os.Create(r.options.Output)
+		if err != nil {
+			gologger.Errorf("Could not create output file: %s\n", err)
+			return
+		}
+		defer file.Close()
+		r.write(file, resources)
+		return
+	}
+	r.write(writer, resources)
+}
+
+// runOnce performs a single enumeration pass, records its outcome for
+// the /healthz, /readyz and /metrics endpoints, and returns the
+// resulting resources.
+func (r *Runner) runOnce(ctx context.Context) *schema.Resources {
+	start := time.Now()
+	resources, err := r.enumerateWithErr(ctx)
+	duration := time.Since(start)
+
+	r.snapshotMu.Lock()
+	r.snapshot = resources
+	r.lastRunAt = start
+	r.lastRunDuration = duration
+	r.lastRunErr = err
+	r.snapshotMu.Unlock()
+
+	if r.options.State != "" {
+		r.diffAndNotify(ctx, resources, err)
+	}
+	return resources
+}
+
+// diffAndNotify persists resources to the configured state backend
+// and, if a previous snapshot already existed, reports the delta to
+// every configured -notify sink. Failures here are logged and never
+// abort the enumeration pass they're attached to.
+//
+// If this pass had any provider error, resources is necessarily a
+// partial set: diffing or saving it would report every missing
+// asset as "removed" to every -notify sink, then "added" again on
+// the next clean run, and would overwrite the last-known-good state
+// with an incomplete one. So a partial pass is skipped entirely,
+// leaving the previously saved snapshot as the diff baseline for the
+// next successful run.
+func (r *Runner) diffAndNotify(ctx context.Context, resources *schema.Resources, enumerateErr error) {
+	if enumerateErr != nil {
+		gologger.Warningf("Skipping state diff/save for a partial enumeration pass: %s\n", enumerateErr)
+		return
+	}
+
+	backend, err := newStateBackend(r.options.State)
+	if err != nil {
+		gologger.Warningf("Could not open state backend: %s\n", err)
+		return
+	}
+
+	previous, err := backend.Load(ctx)
+	if err != nil {
+		gologger.Warningf("Could not load previous state: %s\n", err)
+		return
+	}
+
+	if previous != nil {
+		diff := diffSnapshots(previous, resources)
+		if !diff.IsEmpty() {
+			r.sendNotifications(ctx, diff.String())
+		}
+	}
+
+	if err := backend.Save(ctx, resources); err != nil {
+		gologger.Warningf("Could not save state: %s\n", err)
+	}
+}
+
+// sendNotifications delivers a diff summary to every -notify sink,
+// logging (but not aborting on) any sink that fails.
+func (r *Runner) sendNotifications(ctx context.Context, message string) {
+	for _, target := range r.options.Notify {
+		sink, err := notify.New(target)
+		if err != nil {
+			gologger.Warningf("Could not create notify sink %s: %s\n", target, err)
+			continue
+		}
+		if err := sink.Notify(ctx, message); err != nil {
+			gologger.Warningf("Could not send notification to %s: %s\n", target, err)
+		}
+	}
+}
+
+// Snapshot returns the resources, start time, duration and error of
+// the most recently completed enumeration pass. It is safe to call
+// concurrently with a running enumeration.
+func (r *Runner) Snapshot() (resources *schema.Resources, runAt time.Time, duration time.Duration, err error) {
+	r.snapshotMu.RLock()
+	defer r.snapshotMu.RUnlock()
+	return r.snapshot, r.lastRunAt, r.lastRunDuration, r.lastRunErr
+}
+
+// enumerateWithErr queries every provider in the inventory and merges
+// the returned resources into a single set. A provider failing does
+// not abort the pass; its error is logged and, if at least one
+// provider failed, also returned so callers can surface it (e.g. the
+// /metrics and /healthz endpoints in server mode).
+func (r *Runner) enumerateWithErr(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+	var lastErr error
+	for _, provider := range r.inventory.Providers {
+		items, err := provider.GetResource(ctx)
+		if err != nil {
+			gologger.Warningf("Could not get resources: %s\n", err)
+			lastErr = err
+			continue
+		}
+		list.Merge(items)
+	}
+	return list, lastErr
+}
+
+// write writes the resources to a writer honoring the host/ip/json
+// display options.
+func (r *Runner) write(writer io.Writer, resources *schema.Resources) {
+	for _, resource := range resources.Items {
+		if r.options.Hosts && resource.DNSName == "" {
+			continue
+		}
+		if r.options.IPAddress && resource.PublicIPv4 == "" && resource.PublicIPv6 == "" {
+			continue
+		}
+
+		if r.options.JSON {
+			data, err := json.Marshal(resource)
+			if err != nil {
+				continue
+			}
+			io.WriteString(writer, string(data)+"\n")
+			continue
+		}
+		io.WriteString(writer, resource.String()+"\n")
+	}
+}
@@ -0,0 +1,140 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/projectdiscovery/gologger"
+)
+
+var (
+	assetsPerProvider = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloudlist_assets_per_provider",
+		Help: "Number of assets returned by the last enumeration pass, by provider.",
+	}, []string{"provider"})
+	lastRunDurationSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "cloudlist_last_run_duration_seconds",
+		Help: "Duration in seconds of the last enumeration pass.",
+	})
+	lastRunErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cloudlist_last_run_errors_total",
+		Help: "Count of enumeration passes that completed with at least one provider error.",
+	})
+)
+
+// serve runs cloudlist as a long-running process: it re-enumerates on
+// the configured interval and exposes the last snapshot, health and
+// Prometheus metrics over HTTP until the process is killed.
+func (r *Runner) serve() {
+	r.runOnce(context.Background())
+	r.updateMetrics()
+	go r.reEnumerateForever()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/assets", r.handleAssets)
+	mux.HandleFunc("/healthz", r.handleHealthz)
+	mux.HandleFunc("/readyz", r.handleHealthz)
+	mux.Handle("/metrics", promhttp.Handler())
+
+	gologger.Infof("Listening on %s (re-enumerating every %s)\n", r.options.Listen, r.options.Interval)
+	if err := http.ListenAndServe(r.options.Listen, mux); err != nil {
+		gologger.Fatalf("Could not start server: %s\n", err)
+	}
+}
+
+// reEnumerateForever re-runs enumeration on the configured interval
+// and refreshes the exported Prometheus metrics, until the process
+// exits.
+func (r *Runner) reEnumerateForever() {
+	ticker := time.NewTicker(r.options.Interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		r.runOnce(context.Background())
+		r.updateMetrics()
+	}
+}
+
+// updateMetrics refreshes the Prometheus gauges from the latest
+// snapshot. It is also called once after the first enumeration pass
+// so /metrics has data before the first tick.
+func (r *Runner) updateMetrics() {
+	resources, _, duration, err := r.Snapshot()
+
+	counts := map[string]float64{}
+	if resources != nil {
+		for _, resource := range resources.Items {
+			counts[resource.Provider]++
+		}
+	}
+	assetsPerProvider.Reset()
+	for provider, count := range counts {
+		assetsPerProvider.WithLabelValues(provider).Set(count)
+	}
+	lastRunDurationSeconds.Set(duration.Seconds())
+	if err != nil {
+		lastRunErrors.Inc()
+	}
+}
+
+// handleAssets serves the last enumeration snapshot as JSON, optionally
+// filtered by ?provider= and ?type=host|ip.
+func (r *Runner) handleAssets(w http.ResponseWriter, req *http.Request) {
+	resources, runAt, _, _ := r.Snapshot()
+	if resources == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	filtered := filterAssets(resources, req.URL.Query().Get("provider"), req.URL.Query().Get("type"))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Cloudlist-Last-Run", runAt.Format(time.RFC3339))
+	json.NewEncoder(w).Encode(filtered.Items)
+}
+
+// filterAssets returns the subset of resources matching provider
+// (exact match, ignored if empty) and assetType ("host" requires a
+// DNSName, "ip" requires a PublicIPv4/PublicIPv6, anything else is
+// unfiltered).
+func filterAssets(resources *schema.Resources, provider, assetType string) *schema.Resources {
+	filtered := &schema.Resources{}
+	for _, resource := range resources.Items {
+		if provider != "" && resource.Provider != provider {
+			continue
+		}
+		switch assetType {
+		case "host":
+			if resource.DNSName == "" {
+				continue
+			}
+		case "ip":
+			if resource.PublicIPv4 == "" && resource.PublicIPv6 == "" {
+				continue
+			}
+		}
+		filtered.Append(resource)
+	}
+	return filtered
+}
+
+// handleHealthz reports whether the most recent enumeration pass
+// completed. It backs both /healthz and /readyz: readiness and
+// liveness are the same thing here, since the server has nothing
+// useful to do before its first snapshot exists.
+func (r *Runner) handleHealthz(w http.ResponseWriter, req *http.Request) {
+	resources, runAt, _, _ := r.Snapshot()
+	if resources == nil || runAt.IsZero() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("no enumeration pass has completed yet"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
@@ -0,0 +1,76 @@
+package runner
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+func testResources() *schema.Resources {
+	return &schema.Resources{Items: []*schema.Resource{
+		{Provider: "aws", DNSName: "api.example.com", PublicIPv4: "1.1.1.1"},
+		{Provider: "aws", PublicIPv4: "2.2.2.2"},
+		{Provider: "azure", DNSName: "app.example.com"},
+	}}
+}
+
+func TestFilterAssets(t *testing.T) {
+	tests := []struct {
+		name      string
+		provider  string
+		assetType string
+		want      int
+	}{
+		{name: "no filter returns everything", want: 3},
+		{name: "filter by provider", provider: "aws", want: 2},
+		{name: "filter by unknown provider returns nothing", provider: "gcp", want: 0},
+		{name: "filter by type host", assetType: "host", want: 2},
+		{name: "filter by type ip", assetType: "ip", want: 2},
+		{name: "filter by provider and type", provider: "aws", assetType: "host", want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterAssets(testResources(), tt.provider, tt.assetType)
+			if len(got.Items) != tt.want {
+				t.Errorf("filterAssets() returned %d items, want %d", len(got.Items), tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleAssetsBeforeFirstRun(t *testing.T) {
+	r := &Runner{}
+
+	req := httptest.NewRequest(http.MethodGet, "/assets", nil)
+	w := httptest.NewRecorder()
+	r.handleAssets(w, req)
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestHandleHealthz(t *testing.T) {
+	r := &Runner{}
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	w := httptest.NewRecorder()
+	r.handleHealthz(w, req)
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status before any run = %d, want %d", w.Code, http.StatusServiceUnavailable)
+	}
+
+	r.snapshotMu.Lock()
+	r.snapshot = testResources()
+	r.lastRunAt = time.Now()
+	r.snapshotMu.Unlock()
+
+	w = httptest.NewRecorder()
+	r.handleHealthz(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("status after a run = %d, want %d", w.Code, http.StatusOK)
+	}
+}
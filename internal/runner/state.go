@@ -0,0 +1,72 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// stateBackend persists an enumeration snapshot between runs so it
+// can be diffed against the next one. fileStateBackend is the only
+// implementation today; the interface exists so a store like S3 or
+// GCS can be plugged in later without touching the diff/notify logic.
+type stateBackend interface {
+	Load(ctx context.Context) (*schema.Resources, error)
+	Save(ctx context.Context, resources *schema.Resources) error
+}
+
+// newStateBackend resolves the -state location to a stateBackend. A
+// bare path is treated as a local file; "s3://" and "gs://" are
+// reserved for future remote backends.
+func newStateBackend(location string) (stateBackend, error) {
+	switch {
+	case strings.HasPrefix(location, "s3://"):
+		return nil, errors.New("s3 state backend is not implemented yet, use a local file path")
+	case strings.HasPrefix(location, "gs://"):
+		return nil, errors.New("gcs state backend is not implemented yet, use a local file path")
+	default:
+		return &fileStateBackend{path: location}, nil
+	}
+}
+
+// fileStateBackend stores the snapshot as JSON on local disk.
+type fileStateBackend struct {
+	path string
+}
+
+// Load reads the previously saved snapshot. It returns a nil
+// *schema.Resources, nil error if no snapshot has been saved yet, so
+// the caller can treat the run as a baseline instead of a diff.
+func (f *fileStateBackend) Load(ctx context.Context) (*schema.Resources, error) {
+	data, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state file")
+	}
+
+	resources := &schema.Resources{}
+	if err := json.Unmarshal(data, &resources.Items); err != nil {
+		return nil, errors.Wrap(err, "could not decode state file")
+	}
+	return resources, nil
+}
+
+// Save writes the snapshot as JSON to local disk, overwriting
+// whatever was there before.
+func (f *fileStateBackend) Save(ctx context.Context, resources *schema.Resources) error {
+	data, err := json.Marshal(resources.Items)
+	if err != nil {
+		return errors.Wrap(err, "could not encode state file")
+	}
+	if err := os.WriteFile(f.path, data, 0644); err != nil {
+		return errors.Wrap(err, "could not write state file")
+	}
+	return nil
+}
@@ -3,9 +3,14 @@ package inventory
 import (
 	"fmt"
 
+	"github.com/mishra321shu/cloudlist/pkg/providers/alibaba"
 	"github.com/mishra321shu/cloudlist/pkg/providers/aws"
+	"github.com/mishra321shu/cloudlist/pkg/providers/azure"
 	"github.com/mishra321shu/cloudlist/pkg/providers/digitalocean"
 	"github.com/mishra321shu/cloudlist/pkg/providers/gcp"
+	"github.com/mishra321shu/cloudlist/pkg/providers/ibm"
+	"github.com/mishra321shu/cloudlist/pkg/providers/kubernetes"
+	"github.com/mishra321shu/cloudlist/pkg/providers/oci"
 	"github.com/mishra321shu/cloudlist/pkg/providers/scaleway"
 	"github.com/mishra321shu/cloudlist/pkg/schema"
 	"github.com/projectdiscovery/gologger"
@@ -47,6 +52,16 @@ func nameToProvider(value string, block schema.OptionBlock) (schema.Provider, er
 		return gcp.New(block)
 	case "scw":
 		return scaleway.New(block)
+	case "azure":
+		return azure.New(block)
+	case "kubernetes":
+		return kubernetes.New(block)
+	case "ibm":
+		return ibm.New(block)
+	case "oci":
+		return oci.New(block)
+	case "alibaba":
+		return alibaba.New(block)
 	default:
 		return nil, fmt.Errorf("invalid provider name found: %s", value)
 	}
@@ -0,0 +1,21 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// discordSink posts a message to a Discord incoming webhook.
+type discordSink struct {
+	webhookURL string
+}
+
+func (d *discordSink) Notify(ctx context.Context, message string) error {
+	body, err := json.Marshal(map[string]string{"content": message})
+	if err != nil {
+		return errors.Wrap(err, "could not marshal discord payload")
+	}
+	return postJSON(ctx, d.webhookURL, body)
+}
@@ -0,0 +1,39 @@
+// Package notify implements sinks that cloudlist can ping when a diff
+// run (see internal/runner's -state option) finds new or changed
+// public assets.
+package notify
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Sink is implemented by every notification backend. Message is a
+// pre-formatted, human readable summary of a diff run.
+type Sink interface {
+	Notify(ctx context.Context, message string) error
+}
+
+// New creates a Sink from a "<scheme>:<destination>" URI, e.g.
+// "slack:https://hooks.slack.com/services/xxx",
+// "discord:https://discord.com/api/webhooks/xxx" or
+// "webhook:https://example.com/ingest".
+func New(uri string) (Sink, error) {
+	scheme, destination, ok := strings.Cut(uri, ":")
+	if !ok {
+		return nil, errors.Errorf("invalid notify target %q, expected <scheme>:<destination>", uri)
+	}
+
+	switch scheme {
+	case "slack":
+		return &slackSink{webhookURL: destination}, nil
+	case "discord":
+		return &discordSink{webhookURL: destination}, nil
+	case "webhook":
+		return &webhookSink{url: destination}, nil
+	default:
+		return nil, errors.Errorf("unsupported notify scheme %q", scheme)
+	}
+}
@@ -0,0 +1,42 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// slackSink posts a message to a Slack incoming webhook.
+type slackSink struct {
+	webhookURL string
+}
+
+func (s *slackSink) Notify(ctx context.Context, message string) error {
+	body, err := json.Marshal(map[string]string{"text": message})
+	if err != nil {
+		return errors.Wrap(err, "could not marshal slack payload")
+	}
+	return postJSON(ctx, s.webhookURL, body)
+}
+
+func postJSON(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not send notification")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return errors.Errorf("notification sink returned status %d", resp.StatusCode)
+	}
+	return nil
+}
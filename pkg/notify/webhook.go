@@ -0,0 +1,22 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// webhookSink posts a raw JSON payload to an arbitrary URL, for
+// sinks that don't speak the Slack/Discord message format.
+type webhookSink struct {
+	url string
+}
+
+func (w *webhookSink) Notify(ctx context.Context, message string) error {
+	body, err := json.Marshal(map[string]string{"message": message})
+	if err != nil {
+		return errors.Wrap(err, "could not marshal webhook payload")
+	}
+	return postJSON(ctx, w.url, body)
+}
@@ -0,0 +1,83 @@
+package alibaba
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// providerName is the name of the provider
+const providerName = "alibaba"
+
+// Provider is a provider for Alibaba Cloud API
+type Provider struct {
+	profile         string
+	region          string
+	accessKeyID     string
+	accessKeySecret string
+	ecs             *ecs.Client
+	slb             *slb.Client
+}
+
+// New creates a new provider client for alibaba cloud API
+func New(options schema.OptionBlock) (*Provider, error) {
+	accessKeyID, ok := options.GetMetadata("alibaba_access_key")
+	if !ok {
+		return nil, &schema.ErrNoSuchKey{Name: "alibaba_access_key"}
+	}
+	accessKeySecret, ok := options.GetMetadata("alibaba_access_key_secret")
+	if !ok {
+		return nil, &schema.ErrNoSuchKey{Name: "alibaba_access_key_secret"}
+	}
+	region, _ := options.GetMetadata("alibaba_region")
+	profile, _ := options.GetMetadata("profile")
+	if region == "" {
+		region = "cn-hangzhou"
+	}
+
+	ecsClient, err := ecs.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create ecs client")
+	}
+	slbClient, err := slb.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create slb client")
+	}
+
+	return &Provider{
+		profile:         profile,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		accessKeySecret: accessKeySecret,
+		ecs:             ecsClient,
+		slb:             slbClient,
+	}, nil
+}
+
+// GetResource returns all the resources in the store for a provider.
+func (p *Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	instances, err := p.getECSInstances()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list ecs instances")
+	}
+	list.Merge(instances)
+
+	balancers, err := p.getLoadBalancers()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list load balancers")
+	}
+	list.Merge(balancers)
+
+	records, err := p.getDNSRecords()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list dns records")
+	}
+	list.Merge(records)
+
+	return list, nil
+}
@@ -0,0 +1,61 @@
+package alibaba
+
+import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getDNSRecords returns the A records of every domain managed by
+// Alibaba Cloud DNS under the account, across all pages of both the
+// domain and domain record listings.
+func (p *Provider) getDNSRecords() (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	dnsClient, err := alidns.NewClientWithAccessKey(p.region, p.accessKeyID, p.accessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+
+	domainPageNumber := 1
+	for {
+		domainsRequest := alidns.CreateDescribeDomainsRequest()
+		domainsRequest.PageNumber = requests.NewInteger(domainPageNumber)
+
+		domains, err := dnsClient.DescribeDomains(domainsRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range domains.Domains.Domain {
+			recordPageNumber := 1
+			for {
+				recordsRequest := alidns.CreateDescribeDomainRecordsRequest()
+				recordsRequest.DomainName = domain.DomainName
+				recordsRequest.Type = "A"
+				recordsRequest.PageNumber = requests.NewInteger(recordPageNumber)
+
+				records, err := dnsClient.DescribeDomainRecords(recordsRequest)
+				if err != nil {
+					return nil, err
+				}
+				for _, record := range records.DomainRecords.Record {
+					list.Append(&schema.Resource{
+						Profile:    p.profile,
+						DNSName:    record.RR + "." + record.DomainName,
+						PublicIPv4: record.Value,
+						Public:     true,
+						Provider:   providerName,
+					})
+				}
+				if recordPageNumber*records.PageSize >= records.TotalCount {
+					break
+				}
+				recordPageNumber++
+			}
+		}
+		if domainPageNumber*domains.PageSize >= domains.TotalCount {
+			return list, nil
+		}
+		domainPageNumber++
+	}
+}
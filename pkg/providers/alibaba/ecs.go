@@ -0,0 +1,48 @@
+package alibaba
+
+import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getECSInstances returns every ECS instance with its public and
+// EIP addresses across all pages.
+func (p *Provider) getECSInstances() (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	pageNumber := 1
+	for {
+		request := ecs.CreateDescribeInstancesRequest()
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := p.ecs.DescribeInstances(request)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range response.Instances.Instance {
+			for _, ip := range instance.PublicIpAddress.IpAddress {
+				list.Append(&schema.Resource{
+					Profile:    p.profile,
+					ID:         instance.InstanceId,
+					PublicIPv4: ip,
+					Public:     true,
+					Provider:   providerName,
+				})
+			}
+			if instance.EipAddress.IpAddress != "" {
+				list.Append(&schema.Resource{
+					Profile:    p.profile,
+					ID:         instance.InstanceId,
+					PublicIPv4: instance.EipAddress.IpAddress,
+					Public:     true,
+					Provider:   providerName,
+				})
+			}
+		}
+		if pageNumber*response.PageSize >= response.TotalCount {
+			return list, nil
+		}
+		pageNumber++
+	}
+}
@@ -0,0 +1,40 @@
+package alibaba
+
+import (
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getLoadBalancers returns every internet-facing SLB instance along
+// with its allocated address, across all pages.
+func (p *Provider) getLoadBalancers() (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	pageNumber := 1
+	for {
+		request := slb.CreateDescribeLoadBalancersRequest()
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := p.slb.DescribeLoadBalancers(request)
+		if err != nil {
+			return nil, err
+		}
+		for _, balancer := range response.LoadBalancers.LoadBalancer {
+			if balancer.AddressType != "internet" {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:    p.profile,
+				ID:         balancer.LoadBalancerId,
+				PublicIPv4: balancer.Address,
+				Public:     true,
+				Provider:   providerName,
+			})
+		}
+		if pageNumber*response.PageSize >= response.TotalCount {
+			return list, nil
+		}
+		pageNumber++
+	}
+}
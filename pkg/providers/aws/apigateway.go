@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getAPIGatewayDomains returns every custom domain name configured
+// for REST (v1) and HTTP/WebSocket (v2) API Gateway APIs.
+func (p *awsProvider) getAPIGatewayDomains(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	restClient := apigateway.NewFromConfig(p.config)
+	restPaginator := apigateway.NewGetDomainNamesPaginator(restClient, &apigateway.GetDomainNamesInput{})
+	for restPaginator.HasMorePages() {
+		page, err := restPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range page.Items {
+			if domain.DomainName == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:   p.profile,
+				DNSName:   aws.ToString(domain.DomainName),
+				DNSTarget: aws.ToString(domain.DistributionDomainName),
+				Public:    true,
+				Provider:  providerName,
+			})
+		}
+	}
+
+	v2Client := apigatewayv2.NewFromConfig(p.config)
+	v2Paginator := apigatewayv2.NewGetDomainNamesPaginator(v2Client, &apigatewayv2.GetDomainNamesInput{})
+	for v2Paginator.HasMorePages() {
+		page, err := v2Paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range page.Items {
+			if domain.DomainName == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:  p.profile,
+				DNSName:  aws.ToString(domain.DomainName),
+				Public:   true,
+				Provider: providerName,
+			})
+		}
+	}
+	return list, nil
+}
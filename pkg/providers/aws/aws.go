@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+	"github.com/projectdiscovery/gologger"
+)
+
+// providerName is the name of the provider
+const providerName = "aws"
+
+// awsProvider is a provider for the AWS API. It holds a single
+// loaded aws.Config so every AWS service client shares the same
+// resolved credentials instead of each one resolving its own.
+type awsProvider struct {
+	profile string
+	config  aws.Config
+	route53 *route53.Client
+}
+
+// New creates a new provider client for aws API
+func New(options schema.OptionBlock) (*awsProvider, error) {
+	profile, _ := options.GetMetadata("profile")
+
+	cfg, err := loadConfig(options)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load aws credentials")
+	}
+
+	return &awsProvider{
+		profile: profile,
+		config:  cfg,
+		route53: route53.NewFromConfig(cfg),
+	}, nil
+}
+
+// loadConfig builds an aws.Config for the provider. Credentials are
+// resolved in the following order: static access/secret/session keys
+// from the YAML block, a named shared profile, and finally the
+// default provider chain (environment variables and EC2/ECS/EKS
+// IMDSv2 instance metadata). If aws_assume_role_arn is set, the
+// resolved credentials are exchanged for temporary ones via STS
+// AssumeRole (or AssumeRoleWithWebIdentity when running with IRSA/OIDC
+// web identity credentials already present in the environment).
+func loadConfig(options schema.OptionBlock) (aws.Config, error) {
+	ctx := context.Background()
+
+	var optFns []func(*config.LoadOptions) error
+	if profile, ok := options.GetMetadata("profile"); ok && profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(profile))
+	}
+
+	accessKey, hasAccessKey := options.GetMetadata("aws_access_key")
+	secretKey, hasSecretKey := options.GetMetadata("aws_secret_key")
+	if hasAccessKey && hasSecretKey {
+		sessionToken, _ := options.GetMetadata("aws_session_token")
+		optFns = append(optFns, config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(accessKey, secretKey, sessionToken),
+		))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return aws.Config{}, err
+	}
+
+	if roleARN, ok := options.GetMetadata("aws_assume_role_arn"); ok && roleARN != "" {
+		stsClient := sts.NewFromConfig(cfg)
+		if tokenFile := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"); tokenFile != "" {
+			cfg.Credentials = aws.NewCredentialsCache(stscreds.NewWebIdentityRoleProvider(stsClient, roleARN, stscreds.IdentityTokenFile(tokenFile)))
+		} else {
+			cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, roleARN))
+		}
+	}
+	return cfg, nil
+}
+
+// resourceGetter is implemented by every sub-provider that enumerates
+// one AWS service and returns its assets.
+type resourceGetter func(ctx context.Context) (*schema.Resources, error)
+
+// GetResource returns all the resources in the store for a provider.
+// Every AWS service is enumerated concurrently; one service failing
+// (a permission gap on ECS or Lightsail is a perfectly normal
+// occurrence) is logged and skipped rather than discarding the
+// results every other, successful service already returned.
+func (p *awsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	getters := []resourceGetter{
+		p.getRoute53Records,
+		p.getElasticLoadBalancers,
+		p.getCloudFrontDistributions,
+		p.getAPIGatewayDomains,
+		p.getLightsailResources,
+		p.getS3WebsiteEndpoints,
+		p.getECSEKSEndpoints,
+	}
+
+	results := make([]*schema.Resources, len(getters))
+	var wg sync.WaitGroup
+	for i, getter := range getters {
+		i, getter := i, getter
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			items, err := getter(ctx)
+			if err != nil {
+				gologger.Warningf("Could not enumerate an aws service: %s\n", err)
+				return
+			}
+			results[i] = items
+		}()
+	}
+	wg.Wait()
+
+	list := &schema.Resources{}
+	for _, items := range results {
+		list.Merge(items)
+	}
+	return list, nil
+}
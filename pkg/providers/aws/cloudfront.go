@@ -0,0 +1,47 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getCloudFrontDistributions returns the default domain name and
+// every configured alias (CNAME) for each CloudFront distribution.
+func (p *awsProvider) getCloudFrontDistributions(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client := cloudfront.NewFromConfig(p.config)
+	paginator := cloudfront.NewListDistributionsPaginator(client, &cloudfront.ListDistributionsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, distribution := range page.DistributionList.Items {
+			if distribution.DomainName != nil {
+				list.Append(&schema.Resource{
+					Profile:  p.profile,
+					DNSName:  aws.ToString(distribution.DomainName),
+					Public:   true,
+					Provider: providerName,
+				})
+			}
+			if distribution.Aliases == nil {
+				continue
+			}
+			for _, alias := range distribution.Aliases.Items {
+				list.Append(&schema.Resource{
+					Profile:   p.profile,
+					DNSName:   alias,
+					DNSTarget: aws.ToString(distribution.DomainName),
+					Public:    true,
+					Provider:  providerName,
+				})
+			}
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getECSEKSEndpoints returns the public IP of every ECS service task
+// with a public IP assigned, and the API server endpoint of every EKS
+// cluster reachable from the public internet.
+func (p *awsProvider) getECSEKSEndpoints(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	ecsItems, err := p.getECSServiceEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list.Merge(ecsItems)
+
+	eksItems, err := p.getEKSClusterEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list.Merge(eksItems)
+
+	return list, nil
+}
+
+// getECSServiceEndpoints returns the public IPs of Fargate tasks that
+// have been assigned one across every cluster in the account.
+func (p *awsProvider) getECSServiceEndpoints(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client := ecs.NewFromConfig(p.config)
+	clusterPaginator := ecs.NewListClustersPaginator(client, &ecs.ListClustersInput{})
+	for clusterPaginator.HasMorePages() {
+		clusterPage, err := clusterPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, clusterARN := range clusterPage.ClusterArns {
+			taskPaginator := ecs.NewListTasksPaginator(client, &ecs.ListTasksInput{Cluster: aws.String(clusterARN)})
+			for taskPaginator.HasMorePages() {
+				taskPage, err := taskPaginator.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if len(taskPage.TaskArns) == 0 {
+					continue
+				}
+				// DescribeTasks accepts at most 100 ARNs per call, which
+				// matches ListTasks' own page size, so each page maps to
+				// exactly one DescribeTasks call.
+				tasks, err := client.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+					Cluster: aws.String(clusterARN),
+					Tasks:   taskPage.TaskArns,
+				})
+				if err != nil {
+					return nil, err
+				}
+				for _, task := range tasks.Tasks {
+					for _, attachment := range task.Attachments {
+						for _, detail := range attachment.Details {
+							if detail.Name == nil || *detail.Name != "publicIPv4Address" || detail.Value == nil {
+								continue
+							}
+							list.Append(&schema.Resource{
+								Profile:    p.profile,
+								PublicIPv4: aws.ToString(detail.Value),
+								Public:     true,
+								Provider:   providerName,
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+	return list, nil
+}
+
+// getEKSClusterEndpoints returns the API server endpoint of every EKS
+// cluster that has public endpoint access enabled.
+func (p *awsProvider) getEKSClusterEndpoints(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client := eks.NewFromConfig(p.config)
+	paginator := eks.NewListClustersPaginator(client, &eks.ListClustersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range page.Clusters {
+			cluster, err := client.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(name)})
+			if err != nil {
+				return nil, err
+			}
+			vpcConfig := cluster.Cluster.ResourcesVpcConfig
+			if vpcConfig == nil || !vpcConfig.EndpointPublicAccess || cluster.Cluster.Endpoint == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:  p.profile,
+				DNSName:  aws.ToString(cluster.Cluster.Endpoint),
+				Public:   true,
+				Provider: providerName,
+			})
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getElasticLoadBalancers returns the DNS names of every classic ELB
+// and every ALB/NLB in the account.
+func (p *awsProvider) getElasticLoadBalancers(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	classic := elasticloadbalancing.NewFromConfig(p.config)
+	classicOutput, err := classic.DescribeLoadBalancers(ctx, &elasticloadbalancing.DescribeLoadBalancersInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, lb := range classicOutput.LoadBalancerDescriptions {
+		if lb.DNSName == nil {
+			continue
+		}
+		list.Append(&schema.Resource{
+			Profile:  p.profile,
+			DNSName:  aws.ToString(lb.DNSName),
+			Public:   aws.ToString(lb.Scheme) == "internet-facing",
+			Provider: providerName,
+		})
+	}
+
+	v2 := elasticloadbalancingv2.NewFromConfig(p.config)
+	paginator := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(v2, &elasticloadbalancingv2.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range page.LoadBalancers {
+			if lb.DNSName == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:  p.profile,
+				DNSName:  aws.ToString(lb.DNSName),
+				Public:   string(lb.Scheme) == "internet-facing",
+				Provider: providerName,
+			})
+		}
+	}
+	return list, nil
+}
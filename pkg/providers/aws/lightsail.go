@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getLightsailResources returns every Lightsail instance's public IP
+// along with every static IP allocated in the account.
+func (p *awsProvider) getLightsailResources(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client := lightsail.NewFromConfig(p.config)
+
+	instances, err := client.GetInstances(ctx, &lightsail.GetInstancesInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances.Instances {
+		if instance.PublicIpAddress == nil {
+			continue
+		}
+		list.Append(&schema.Resource{
+			Profile:    p.profile,
+			ID:         aws.ToString(instance.Name),
+			PublicIPv4: aws.ToString(instance.PublicIpAddress),
+			Public:     true,
+			Provider:   providerName,
+		})
+	}
+
+	staticIPs, err := client.GetStaticIps(ctx, &lightsail.GetStaticIpsInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range staticIPs.StaticIps {
+		if ip.IpAddress == nil {
+			continue
+		}
+		list.Append(&schema.Resource{
+			Profile:    p.profile,
+			PublicIPv4: aws.ToString(ip.IpAddress),
+			Public:     true,
+			Provider:   providerName,
+		})
+	}
+	return list, nil
+}
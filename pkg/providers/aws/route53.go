@@ -4,75 +4,71 @@ import (
 	"context"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/pkg/errors"
 	"github.com/mishra321shu/cloudlist/pkg/schema"
 )
 
-// route53Provider is a provider for aws Route53 API
-type route53Provider struct {
-	profile string
-	route53 *route53.Route53
-	session *session.Session
-}
-
-// GetResource returns all the resources in the store for a provider.
-func (d *route53Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+// getRoute53Records returns all the A, AAAA, CNAME and ALIAS records
+// in the store for a provider.
+func (p *awsProvider) getRoute53Records(ctx context.Context) (*schema.Resources, error) {
 	list := &schema.Resources{}
 
-	req := &route53.ListHostedZonesInput{}
-	for {
-		zoneOutput, err := d.route53.ListHostedZones(req)
+	paginator := route53.NewListHostedZonesPaginator(p.route53, &route53.ListHostedZonesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not list hosted zones")
 		}
-		for _, zone := range zoneOutput.HostedZones {
-			items, err := d.listResourceRecords(*zone.Id)
+		for _, zone := range page.HostedZones {
+			items, err := p.listResourceRecords(ctx, *zone.Id)
 			if err != nil {
 				return nil, errors.Wrap(err, "could not list hosted zones records")
 			}
 			list.Merge(items)
 		}
-		if aws.BoolValue(zoneOutput.IsTruncated) && *zoneOutput.NextMarker != "" {
-			req.SetMarker(*zoneOutput.Marker)
-		} else {
-			return list, nil
-		}
 	}
+	return list, nil
 }
 
 // listResourceRecords lists the resource records for a hosted route53 zone.
-func (d *route53Provider) listResourceRecords(zone string) (*schema.Resources, error) {
+func (p *awsProvider) listResourceRecords(ctx context.Context, zone string) (*schema.Resources, error) {
 	req := &route53.ListResourceRecordSetsInput{HostedZoneId: aws.String(zone)}
 	list := &schema.Resources{}
 
 	for {
-		sets, err := d.route53.ListResourceRecordSets(req)
+		sets, err := p.route53.ListResourceRecordSets(ctx, req)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not list resource_record set")
 		}
 		for _, item := range sets.ResourceRecordSets {
-			if *item.Type != "A" {
-				continue
-			}
 			name := strings.TrimSuffix(*item.Name, ".")
+			resource := &schema.Resource{
+				Profile:  p.profile,
+				DNSName:  name,
+				Public:   true,
+				Provider: providerName,
+			}
 
-			var ip4 string
-			if len(item.ResourceRecords) >= 1 {
-				ip4 = aws.StringValue(item.ResourceRecords[0].Value)
+			switch {
+			case item.AliasTarget != nil:
+				resource.DNSTarget = strings.TrimSuffix(aws.ToString(item.AliasTarget.DNSName), ".")
+			case item.Type == types.RRTypeA && len(item.ResourceRecords) >= 1:
+				resource.PublicIPv4 = aws.ToString(item.ResourceRecords[0].Value)
+			case item.Type == types.RRTypeAaaa && len(item.ResourceRecords) >= 1:
+				resource.PublicIPv6 = aws.ToString(item.ResourceRecords[0].Value)
+			case item.Type == types.RRTypeCname && len(item.ResourceRecords) >= 1:
+				resource.DNSTarget = strings.TrimSuffix(aws.ToString(item.ResourceRecords[0].Value), ".")
+			default:
+				continue
 			}
-			list.Append(&schema.Resource{
-				Profile:    d.profile,
-				DNSName:    name,
-				Public:     true,
-				PublicIPv4: ip4,
-				Provider:   providerName,
-			})
+			list.Append(resource)
 		}
-		if aws.BoolValue(sets.IsTruncated) && *sets.NextRecordName != "" {
-			req.SetStartRecordName(*sets.NextRecordName)
+		if aws.ToBool(sets.IsTruncated) && sets.NextRecordName != nil {
+			req.StartRecordName = sets.NextRecordName
+			req.StartRecordType = sets.NextRecordType
 		} else {
 			return list, nil
 		}
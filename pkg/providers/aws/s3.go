@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	smithy "github.com/aws/smithy-go"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getS3WebsiteEndpoints returns the static website endpoint of every
+// bucket in the account that has website hosting enabled.
+func (p *awsProvider) getS3WebsiteEndpoints(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client := s3.NewFromConfig(p.config)
+	buckets, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, bucket := range buckets.Buckets {
+		if bucket.Name == nil {
+			continue
+		}
+
+		_, err := client.GetBucketWebsite(ctx, &s3.GetBucketWebsiteInput{Bucket: bucket.Name})
+		if err != nil {
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchWebsiteConfiguration" {
+				continue
+			}
+			return nil, err
+		}
+
+		location, err := client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{Bucket: bucket.Name})
+		if err != nil {
+			return nil, err
+		}
+		region := string(location.LocationConstraint)
+		if region == "" {
+			region = "us-east-1"
+		}
+
+		list.Append(&schema.Resource{
+			Profile:  p.profile,
+			DNSName:  s3WebsiteEndpoint(*bucket.Name, region),
+			Public:   true,
+			Provider: providerName,
+		})
+	}
+	return list, nil
+}
+
+// dashStyleWebsiteRegions is the set of regions whose S3 static website
+// endpoint uses the legacy "s3-website-<region>" form. Every other
+// (newer) region uses the dotted "s3-website.<region>" form instead; see
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/WebsiteEndpoints.html.
+var dashStyleWebsiteRegions = map[string]bool{
+	"us-east-1":      true,
+	"us-west-1":      true,
+	"us-west-2":      true,
+	"eu-west-1":      true,
+	"ap-southeast-1": true,
+	"ap-southeast-2": true,
+	"ap-northeast-1": true,
+	"sa-east-1":      true,
+}
+
+// s3WebsiteEndpoint returns the static website hostname for a bucket in
+// a region, using whichever of the two endpoint forms AWS actually
+// serves for that region.
+func s3WebsiteEndpoint(bucket, region string) string {
+	if dashStyleWebsiteRegions[region] {
+		return fmt.Sprintf("%s.s3-website-%s.amazonaws.com", bucket, region)
+	}
+	return fmt.Sprintf("%s.s3-website.%s.amazonaws.com", bucket, region)
+}
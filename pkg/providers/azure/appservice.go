@@ -0,0 +1,45 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice/v3"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getAppServices returns all the web apps along with their default
+// and custom hostnames.
+func (p *Provider) getAppServices(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client, err := armappservice.NewWebAppsClient(p.subscriptionID, p.cred, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create app service client")
+	}
+
+	pager := client.NewListPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get app service page")
+		}
+		for _, site := range page.Value {
+			if site.Properties == nil {
+				continue
+			}
+			for _, hostname := range site.Properties.HostNames {
+				if hostname == nil {
+					continue
+				}
+				list.Append(&schema.Resource{
+					Profile:  p.profile,
+					DNSName:  *hostname,
+					Public:   true,
+					Provider: providerName,
+				})
+			}
+		}
+	}
+	return list, nil
+}
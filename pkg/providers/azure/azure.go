@@ -0,0 +1,82 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// providerName is the name of the provider
+const providerName = "azure"
+
+// Provider is a provider for Azure API
+type Provider struct {
+	profile        string
+	subscriptionID string
+	cred           azcore.TokenCredential
+}
+
+// New creates a new provider client for azure API
+func New(options schema.OptionBlock) (*Provider, error) {
+	subscriptionID, ok := options.GetMetadata("azure_subscription_id")
+	if !ok {
+		return nil, &schema.ErrNoSuchKey{Name: "azure_subscription_id"}
+	}
+	profile, _ := options.GetMetadata("profile")
+	tenantID, _ := options.GetMetadata("azure_tenant_id")
+	clientID, _ := options.GetMetadata("azure_client_id")
+	clientSecret, _ := options.GetMetadata("azure_client_secret")
+
+	var cred azcore.TokenCredential
+	var err error
+	if tenantID != "" && clientID != "" && clientSecret != "" {
+		cred, err = azidentity.NewClientSecretCredential(tenantID, clientID, clientSecret, nil)
+	} else {
+		cred, err = azidentity.NewDefaultAzureCredential(nil)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create azure credentials")
+	}
+
+	return &Provider{profile: profile, subscriptionID: subscriptionID, cred: cred}, nil
+}
+
+// GetResource returns all the resources in the store for a provider.
+func (p *Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	publicIPs, err := p.getPublicIPAddresses(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list public ip addresses")
+	}
+	list.Merge(publicIPs)
+
+	interfaces, err := p.getNetworkInterfaces(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list network interfaces")
+	}
+	list.Merge(interfaces)
+
+	vms, err := p.getVirtualMachines(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list virtual machines")
+	}
+	list.Merge(vms)
+
+	apps, err := p.getAppServices(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list app services")
+	}
+	list.Merge(apps)
+
+	zones, err := p.getDNSZoneRecords(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list dns zone records")
+	}
+	list.Merge(zones)
+
+	return list, nil
+}
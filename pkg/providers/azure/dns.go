@@ -0,0 +1,84 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dns/armdns"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getDNSZoneRecords returns the A and CNAME record sets for every
+// Azure DNS zone in the subscription. Every other record type (NS,
+// SOA, TXT, MX, ...) is skipped since it carries no host or target to
+// report.
+func (p *Provider) getDNSZoneRecords(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	zonesClient, err := armdns.NewZonesClient(p.subscriptionID, p.cred, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create dns zones client")
+	}
+	recordsClient, err := armdns.NewRecordSetsClient(p.subscriptionID, p.cred, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create dns record sets client")
+	}
+
+	zonePager := zonesClient.NewListPager(nil)
+	for zonePager.More() {
+		zonePage, err := zonePager.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get dns zone page")
+		}
+		for _, zone := range zonePage.Value {
+			if zone.Name == nil {
+				continue
+			}
+			resourceGroup := resourceGroupFromID(*zone.ID)
+
+			recordPager := recordsClient.NewListAllByDNSZonePager(resourceGroup, *zone.Name, nil)
+			for recordPager.More() {
+				recordPage, err := recordPager.NextPage(ctx)
+				if err != nil {
+					return nil, errors.Wrap(err, "could not get dns record page")
+				}
+				for _, record := range recordPage.Value {
+					if record.Name == nil || record.Properties == nil {
+						continue
+					}
+					name := strings.TrimSuffix(*record.Name+"."+*zone.Name, ".")
+					resource := &schema.Resource{
+						Profile:  p.profile,
+						DNSName:  name,
+						Public:   true,
+						Provider: providerName,
+					}
+
+					switch {
+					case len(record.Properties.ARecords) > 0 && record.Properties.ARecords[0].IPv4Address != nil:
+						resource.PublicIPv4 = *record.Properties.ARecords[0].IPv4Address
+					case record.Properties.CnameRecord != nil && record.Properties.CnameRecord.Cname != nil:
+						resource.DNSTarget = strings.TrimSuffix(*record.Properties.CnameRecord.Cname, ".")
+					default:
+						continue
+					}
+					list.Append(resource)
+				}
+			}
+		}
+	}
+	return list, nil
+}
+
+// resourceGroupFromID extracts the resource group name from an
+// Azure resource ID.
+func resourceGroupFromID(id string) string {
+	parts := strings.Split(id, "/")
+	for i, part := range parts {
+		if strings.EqualFold(part, "resourceGroups") && i+1 < len(parts) {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
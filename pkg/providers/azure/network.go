@@ -0,0 +1,116 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v2"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getPublicIPAddresses returns all the public IP addresses allocated
+// to the subscription.
+func (p *Provider) getPublicIPAddresses(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client, err := armnetwork.NewPublicIPAddressesClient(p.subscriptionID, p.cred, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create public ip client")
+	}
+
+	pager := client.NewListAllPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get public ip page")
+		}
+		for _, address := range page.Value {
+			if address.Properties == nil || address.Properties.IPAddress == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:    p.profile,
+				PublicIPv4: *address.Properties.IPAddress,
+				Public:     true,
+				Provider:   providerName,
+			})
+		}
+	}
+	return list, nil
+}
+
+// getNetworkInterfaces returns the resolved public IP address of every
+// NIC IP configuration that has one attached, tagged with the owning
+// NIC's name as ID. Every such IP is necessarily also returned (without
+// an owning NIC) by getPublicIPAddresses, since a subscription's public
+// IP addresses are enumerable on their own regardless of what they're
+// attached to; this getter exists only to attribute each one to the
+// NIC using it, not to re-discover IPs getPublicIPAddresses missed.
+func (p *Provider) getNetworkInterfaces(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	nicClient, err := armnetwork.NewInterfacesClient(p.subscriptionID, p.cred, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create network interface client")
+	}
+	ipClient, err := armnetwork.NewPublicIPAddressesClient(p.subscriptionID, p.cred, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create public ip client")
+	}
+
+	pager := nicClient.NewListAllPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get network interface page")
+		}
+		for _, item := range page.Value {
+			if item.Properties == nil || item.Name == nil {
+				continue
+			}
+			for _, config := range item.Properties.IPConfigurations {
+				if config.Properties == nil || config.Properties.PublicIPAddress == nil || config.Properties.PublicIPAddress.ID == nil {
+					continue
+				}
+
+				resourceGroup, name, err := parsePublicIPAddressID(*config.Properties.PublicIPAddress.ID)
+				if err != nil {
+					continue
+				}
+				resolved, err := ipClient.Get(ctx, resourceGroup, name, nil)
+				if err != nil || resolved.Properties == nil || resolved.Properties.IPAddress == nil {
+					continue
+				}
+
+				list.Append(&schema.Resource{
+					Profile:    p.profile,
+					ID:         *item.Name,
+					PublicIPv4: *resolved.Properties.IPAddress,
+					Public:     true,
+					Provider:   providerName,
+				})
+			}
+		}
+	}
+	return list, nil
+}
+
+// parsePublicIPAddressID extracts the resource group and name from an
+// ARM public IP address resource ID, e.g.
+// "/subscriptions/xxx/resourceGroups/rg1/providers/Microsoft.Network/publicIPAddresses/ip1".
+func parsePublicIPAddressID(id string) (resourceGroup, name string, err error) {
+	parts := strings.Split(strings.Trim(id, "/"), "/")
+	for i, part := range parts {
+		if strings.EqualFold(part, "resourceGroups") && i+1 < len(parts) {
+			resourceGroup = parts[i+1]
+		}
+	}
+	if len(parts) > 0 {
+		name = parts[len(parts)-1]
+	}
+	if resourceGroup == "" || name == "" {
+		return "", "", errors.Errorf("could not parse public ip address id: %s", id)
+	}
+	return resourceGroup, name, nil
+}
@@ -0,0 +1,42 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getVirtualMachines returns all the virtual machines in the
+// subscription, each identified by its name and marked non-public. No
+// IP addresses are attached to these resources; public IPs are
+// reported by getPublicIPAddresses and getNetworkInterfaces instead.
+func (p *Provider) getVirtualMachines(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client, err := armcompute.NewVirtualMachinesClient(p.subscriptionID, p.cred, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create virtual machines client")
+	}
+
+	pager := client.NewListAllPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get virtual machines page")
+		}
+		for _, vm := range page.Value {
+			if vm.Name == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:  p.profile,
+				ID:       *vm.Name,
+				Public:   false,
+				Provider: providerName,
+			})
+		}
+	}
+	return list, nil
+}
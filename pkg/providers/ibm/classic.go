@@ -0,0 +1,49 @@
+package ibm
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+)
+
+// getClassicInfrastructureHosts returns every virtual guest host with a
+// primary IP provisioned on IBM Cloud Classic Infrastructure; bare-metal
+// hardware is not listed. Classic
+// Infrastructure uses its own SoftLayer username/API key pair, distinct
+// from the ibm_api_key used for VPC and DNS, so this is skipped unless
+// ibm_classic_username/ibm_classic_api_key are also configured.
+func (p *Provider) getClassicInfrastructureHosts(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	if p.classicUsername == "" || p.classicAPIKey == "" {
+		return list, nil
+	}
+
+	sess := session.New(p.classicUsername, p.classicAPIKey)
+	guests, err := services.GetAccountService(sess).
+		Mask("primaryIpAddress,fullyQualifiedDomainName").
+		GetVirtualGuests()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list virtual guests")
+	}
+	for _, guest := range guests {
+		if guest.PrimaryIpAddress == nil {
+			continue
+		}
+		var name string
+		if guest.FullyQualifiedDomainName != nil {
+			name = *guest.FullyQualifiedDomainName
+		}
+		list.Append(&schema.Resource{
+			Profile:    p.profile,
+			DNSName:    name,
+			PublicIPv4: *guest.PrimaryIpAddress,
+			Public:     true,
+			Provider:   providerName,
+		})
+	}
+	return list, nil
+}
@@ -0,0 +1,76 @@
+package ibm
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getDNSRecords returns the A records of every zone managed by IBM
+// Cloud DNS Services for the configured account.
+func (p *Provider) getDNSRecords(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	if p.dnsInstanceID == "" {
+		return list, nil
+	}
+	instanceID := p.dnsInstanceID
+
+	dnsService, err := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+		Authenticator: &core.IamAuthenticator{ApiKey: p.apiKey},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create ibm dns client")
+	}
+
+	zones, _, err := dnsService.ListDnszonesWithContext(ctx, &dnssvcsv1.ListDnszonesOptions{
+		InstanceID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list dns zones")
+	}
+	for _, zone := range zones.Dnszones {
+		records, _, err := dnsService.ListResourceRecordsWithContext(ctx, &dnssvcsv1.ListResourceRecordsOptions{
+			InstanceID: core.StringPtr(instanceID),
+			DnszoneID:  zone.ID,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list dns zone records")
+		}
+		for _, record := range records.ResourceRecords {
+			if record.Type == nil || *record.Type != "A" || record.Name == nil {
+				continue
+			}
+			ip := aRecordIP(record.Rdata)
+			if ip == "" {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:    p.profile,
+				DNSName:    *record.Name,
+				Public:     true,
+				PublicIPv4: ip,
+				Provider:   providerName,
+			})
+		}
+	}
+	return list, nil
+}
+
+// aRecordIP extracts the IPv4 address from an A record's Rdata, which
+// the SDK represents as a generic map (e.g. {"ip": "1.2.3.4"}) rather
+// than a typed field.
+func aRecordIP(rdata interface{}) string {
+	data, ok := rdata.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	ip, ok := data["ip"].(string)
+	if !ok {
+		return ""
+	}
+	return ip
+}
@@ -0,0 +1,127 @@
+package ibm
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+	"github.com/projectdiscovery/gologger"
+)
+
+// providerName is the name of the provider
+const providerName = "ibm"
+
+// Provider is a provider for IBM Cloud API
+type Provider struct {
+	profile         string
+	apiKey          string
+	dnsInstanceID   string
+	classicUsername string
+	classicAPIKey   string
+	vpc             *vpcv1.VpcV1
+}
+
+// New creates a new provider client for ibm cloud API
+func New(options schema.OptionBlock) (*Provider, error) {
+	apiKey, ok := options.GetMetadata("ibm_api_key")
+	if !ok {
+		return nil, &schema.ErrNoSuchKey{Name: "ibm_api_key"}
+	}
+	region, _ := options.GetMetadata("ibm_region")
+	dnsInstanceID, _ := options.GetMetadata("ibm_dns_instance_id")
+	profile, _ := options.GetMetadata("profile")
+	classicUsername, _ := options.GetMetadata("ibm_classic_username")
+	classicAPIKey, _ := options.GetMetadata("ibm_classic_api_key")
+
+	vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		Authenticator: &core.IamAuthenticator{ApiKey: apiKey},
+		URL:           regionToURL(region),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create ibm vpc client")
+	}
+	return &Provider{
+		profile:         profile,
+		apiKey:          apiKey,
+		dnsInstanceID:   dnsInstanceID,
+		classicUsername: classicUsername,
+		classicAPIKey:   classicAPIKey,
+		vpc:             vpcService,
+	}, nil
+}
+
+// regionToURL returns the VPC service endpoint for a region, falling
+// back to the default one when none is configured.
+func regionToURL(region string) string {
+	if region == "" {
+		return "https://us-south.iaas.cloud.ibm.com/v1"
+	}
+	return "https://" + region + ".iaas.cloud.ibm.com/v1"
+}
+
+// GetResource returns all the resources in the store for a provider.
+// Floating IPs, classic infrastructure and DNS records are enumerated
+// independently; one of them failing (classic infrastructure access
+// requires separate SoftLayer credentials that not every account
+// configures) is logged and skipped rather than discarding the results
+// every other, successful getter already returned.
+func (p *Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	if floatingIPs, err := p.getFloatingIPs(ctx); err != nil {
+		gologger.Warningf("Could not list ibm floating ips: %s\n", err)
+	} else {
+		list.Merge(floatingIPs)
+	}
+
+	if classicHosts, err := p.getClassicInfrastructureHosts(ctx); err != nil {
+		gologger.Warningf("Could not list ibm classic infrastructure hosts: %s\n", err)
+	} else {
+		list.Merge(classicHosts)
+	}
+
+	if dnsRecords, err := p.getDNSRecords(ctx); err != nil {
+		gologger.Warningf("Could not list ibm dns records: %s\n", err)
+	} else {
+		list.Merge(dnsRecords)
+	}
+
+	return list, nil
+}
+
+// getFloatingIPs returns all the floating IPs allocated in the VPC.
+func (p *Provider) getFloatingIPs(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	start := ""
+	for {
+		opts := &vpcv1.ListFloatingIpsOptions{}
+		if start != "" {
+			opts.SetStart(start)
+		}
+		result, _, err := p.vpc.ListFloatingIpsWithContext(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range result.FloatingIps {
+			if ip.Address == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:    p.profile,
+				PublicIPv4: *ip.Address,
+				Public:     true,
+				Provider:   providerName,
+			})
+		}
+		if result.Next == nil || result.Next.Href == nil {
+			return list, nil
+		}
+		start, err = core.GetQueryParam(result.Next.Href, "start")
+		if err != nil || start == "" {
+			return list, nil
+		}
+	}
+}
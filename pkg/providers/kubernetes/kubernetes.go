@@ -0,0 +1,180 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// providerName is the name of the provider
+const providerName = "kubernetes"
+
+// Provider is a provider for the Kubernetes API
+type Provider struct {
+	profile string
+	client  kubernetes.Interface
+}
+
+// New creates a new provider client for kubernetes API
+func New(options schema.OptionBlock) (*Provider, error) {
+	profile, _ := options.GetMetadata("profile")
+	kubeconfig, _ := options.GetMetadata("kubernetes_kubeconfig")
+
+	config, err := loadConfig(kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load kubernetes config")
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create kubernetes client")
+	}
+	return &Provider{profile: profile, client: client}, nil
+}
+
+// loadConfig builds a rest.Config, preferring the supplied kubeconfig
+// path, falling back to in-cluster credentials when none is provided,
+// and to the default kubeconfig loading rules ($KUBECONFIG or
+// ~/.kube/config) when not running in a cluster.
+func loadConfig(kubeconfig string) (*rest.Config, error) {
+	if kubeconfig != "" {
+		return clientcmd.BuildConfigFromFlags("", kubeconfig)
+	}
+	if config, err := rest.InClusterConfig(); err == nil {
+		return config, nil
+	}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{},
+	).ClientConfig()
+}
+
+// GetResource returns all the resources in the store for a provider.
+func (p *Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	nodeIPs, err := p.getNodeExternalIPs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list nodes")
+	}
+
+	services, err := p.getServices(ctx, nodeIPs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list services")
+	}
+	list.Merge(services)
+
+	ingresses, err := p.getIngresses(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list ingresses")
+	}
+	list.Merge(ingresses)
+
+	for _, nodeIP := range nodeIPs {
+		list.Append(&schema.Resource{
+			Profile:    p.profile,
+			PublicIPv4: nodeIP,
+			Public:     true,
+			Provider:   providerName,
+		})
+	}
+
+	return list, nil
+}
+
+// getServices returns the externally reachable addresses of every
+// LoadBalancer and NodePort service in the cluster. A NodePort
+// service is only actually reachable on its allocated port over a
+// node's external IP, so it's reported as one resource per
+// (node IP, NodePort) pair, carrying the port in ID for traceability;
+// nodeless clusters (nodeIPs empty) yield no NodePort resources
+// rather than a blanket, unreachable "public" entry.
+func (p *Provider) getServices(ctx context.Context, nodeIPs []string) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	services, err := p.client.CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services.Items {
+		switch service.Spec.Type {
+		case corev1.ServiceTypeLoadBalancer:
+			for _, ingress := range service.Status.LoadBalancer.Ingress {
+				list.Append(&schema.Resource{
+					Profile:    p.profile,
+					DNSName:    ingress.Hostname,
+					PublicIPv4: ingress.IP,
+					Public:     true,
+					Provider:   providerName,
+				})
+			}
+		case corev1.ServiceTypeNodePort:
+			for _, port := range service.Spec.Ports {
+				if port.NodePort == 0 {
+					continue
+				}
+				for _, nodeIP := range nodeIPs {
+					list.Append(&schema.Resource{
+						Profile:    p.profile,
+						ID:         fmt.Sprintf("%s/%s:%d", service.Namespace, service.Name, port.NodePort),
+						PublicIPv4: nodeIP,
+						Public:     true,
+						Provider:   providerName,
+					})
+				}
+			}
+		}
+	}
+	return list, nil
+}
+
+// getIngresses returns the hosts configured on every ingress object
+// in the cluster.
+func (p *Provider) getIngresses(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	ingresses, err := p.client.NetworkingV1().Ingresses(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, ingress := range ingresses.Items {
+		for _, rule := range ingress.Spec.Rules {
+			if rule.Host == "" {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:  p.profile,
+				DNSName:  rule.Host,
+				Public:   true,
+				Provider: providerName,
+			})
+		}
+	}
+	return list, nil
+}
+
+// getNodeExternalIPs returns the external IP addresses of every node
+// in the cluster. It backs both the node resources emitted by
+// GetResource and the NodePort resolution in getServices.
+func (p *Provider) getNodeExternalIPs(ctx context.Context) ([]string, error) {
+	var ips []string
+
+	nodes, err := p.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes.Items {
+		for _, address := range node.Status.Addresses {
+			if address.Type != corev1.NodeExternalIP {
+				continue
+			}
+			ips = append(ips, address.Address)
+		}
+	}
+	return ips, nil
+}
@@ -0,0 +1,50 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getComputeInstances returns every compute instance in the
+// compartment along with its public IP, if one is attached.
+func (p *Provider) getComputeInstances(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	instances, err := p.compute.ListInstances(ctx, core.ListInstancesRequest{
+		CompartmentId: &p.compartmentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances.Items {
+		vnicAttachments, err := p.compute.ListVnicAttachments(ctx, core.ListVnicAttachmentsRequest{
+			CompartmentId: &p.compartmentID,
+			InstanceId:    instance.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, attachment := range vnicAttachments.Items {
+			if attachment.VnicId == nil {
+				continue
+			}
+			vnic, err := p.vcn.GetVnic(ctx, core.GetVnicRequest{VnicId: attachment.VnicId})
+			if err != nil {
+				return nil, err
+			}
+			if vnic.PublicIp == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:    p.profile,
+				PublicIPv4: *vnic.PublicIp,
+				Public:     true,
+				Provider:   providerName,
+			})
+		}
+	}
+	return list, nil
+}
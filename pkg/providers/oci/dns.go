@@ -0,0 +1,53 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	ocidns "github.com/oracle/oci-go-sdk/v65/dns"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getDNSZoneRecords returns the A records of every zone managed by
+// OCI public DNS for the compartment.
+func (p *Provider) getDNSZoneRecords(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client, err := ocidns.NewDnsClientWithConfigurationProvider(p.compute.ConfigurationProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := client.ListZones(ctx, ocidns.ListZonesRequest{
+		CompartmentId: &p.compartmentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, zone := range zones.Items {
+		if zone.Name == nil {
+			continue
+		}
+		rtype := "A"
+		records, err := client.GetZoneRecords(ctx, ocidns.GetZoneRecordsRequest{
+			ZoneNameOrId: zone.Name,
+			Rtype:        &rtype,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records.Items {
+			if record.Domain == nil || record.Rdata == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:    p.profile,
+				DNSName:    strings.TrimSuffix(*record.Domain, "."),
+				Public:     true,
+				PublicIPv4: *record.Rdata,
+				Provider:   providerName,
+			})
+		}
+	}
+	return list, nil
+}
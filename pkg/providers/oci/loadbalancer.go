@@ -0,0 +1,41 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// getLoadBalancers returns the IP addresses of every load balancer in
+// the compartment, public and private alike; each address is marked
+// Public only when OCI reports it as public.
+func (p *Provider) getLoadBalancers(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	client, err := loadbalancer.NewLoadBalancerClientWithConfigurationProvider(p.compute.ConfigurationProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	balancers, err := client.ListLoadBalancers(ctx, loadbalancer.ListLoadBalancersRequest{
+		CompartmentId: &p.compartmentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, lb := range balancers.Items {
+		for _, ip := range lb.IpAddresses {
+			if ip.IpAddress == nil {
+				continue
+			}
+			list.Append(&schema.Resource{
+				Profile:    p.profile,
+				PublicIPv4: *ip.IpAddress,
+				Public:     ip.IsPublic != nil && *ip.IsPublic,
+				Provider:   providerName,
+			})
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,78 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	"github.com/mishra321shu/cloudlist/pkg/schema"
+)
+
+// providerName is the name of the provider
+const providerName = "oci"
+
+// Provider is a provider for Oracle Cloud Infrastructure API
+type Provider struct {
+	profile       string
+	compartmentID string
+	compute       core.ComputeClient
+	vcn           core.VirtualNetworkClient
+}
+
+// New creates a new provider client for oci API
+func New(options schema.OptionBlock) (*Provider, error) {
+	compartmentID, ok := options.GetMetadata("oci_compartment_id")
+	if !ok {
+		return nil, &schema.ErrNoSuchKey{Name: "oci_compartment_id"}
+	}
+	profile, _ := options.GetMetadata("profile")
+	configProfile, hasConfigProfile := options.GetMetadata("oci_config_profile")
+
+	var provider common.ConfigurationProvider
+	var err error
+	if hasConfigProfile && configProfile != "" {
+		provider, err = common.ConfigurationProviderFromFileWithProfile("", configProfile, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load oci config profile")
+		}
+	} else {
+		provider = common.DefaultConfigProvider()
+	}
+
+	computeClient, err := core.NewComputeClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create oci compute client")
+	}
+	vcnClient, err := core.NewVirtualNetworkClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create oci virtual network client")
+	}
+
+	return &Provider{profile: profile, compartmentID: compartmentID, compute: computeClient, vcn: vcnClient}, nil
+}
+
+// GetResource returns all the resources in the store for a provider.
+func (p *Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	list := &schema.Resources{}
+
+	instances, err := p.getComputeInstances(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list compute instances")
+	}
+	list.Merge(instances)
+
+	balancers, err := p.getLoadBalancers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list load balancers")
+	}
+	list.Merge(balancers)
+
+	zones, err := p.getDNSZoneRecords(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list dns zone records")
+	}
+	list.Merge(zones)
+
+	return list, nil
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+)
+
+// Provider is implemented by every cloud provider supported by
+// cloudlist. GetResource enumerates the assets the provider is
+// configured to find.
+type Provider interface {
+	GetResource(ctx context.Context) (*Resources, error)
+}
+
+// OptionBlock is a single provider's configuration block, as read
+// from one entry of the YAML config file.
+type OptionBlock map[string]string
+
+// GetMetadata returns a key from the option block.
+func (o OptionBlock) GetMetadata(key string) (string, bool) {
+	value, ok := o[key]
+	return value, ok
+}
+
+// Options is the full list of provider blocks read from the config
+// file, one per configured provider instance.
+type Options []OptionBlock
+
+// ErrNoSuchKey is returned by a provider's New function when a
+// required metadata key is missing from its option block.
+type ErrNoSuchKey struct {
+	Name string
+}
+
+func (e *ErrNoSuchKey) Error() string {
+	return fmt.Sprintf("could not find metadata key: %s", e.Name)
+}
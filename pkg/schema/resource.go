@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Resource is a single asset discovered by a provider, normalized
+// across clouds so the runner can filter, print and diff it without
+// knowing which provider produced it.
+type Resource struct {
+	// Profile is the name of the provider profile this resource was
+	// found under, as configured in the YAML config file.
+	Profile string `json:"profile,omitempty"`
+	// ID is a provider-specific identifier, used when a resource has
+	// no meaningful DNS name or IP of its own.
+	ID string `json:"id,omitempty"`
+	// Public reports whether the resource is reachable from outside
+	// the cloud account/cluster it was found in.
+	Public bool `json:"public"`
+	// DNSName is the resource's DNS name, if it has one.
+	DNSName string `json:"dns_name,omitempty"`
+	// DNSTarget is the DNS name or load balancer this resource's
+	// DNSName resolves to, for CNAME/ALIAS style records.
+	DNSTarget string `json:"dns_target,omitempty"`
+	// PublicIPv4 is the resource's public IPv4 address, if it has one.
+	PublicIPv4 string `json:"public_ipv4,omitempty"`
+	// PublicIPv6 is the resource's public IPv6 address, if it has one.
+	PublicIPv6 string `json:"public_ipv6,omitempty"`
+	// Provider is the name of the provider that found this resource.
+	Provider string `json:"provider,omitempty"`
+}
+
+// String returns a human readable representation of the resource,
+// preferring its DNS name over raw IP addresses.
+func (r *Resource) String() string {
+	value := r.ID
+	switch {
+	case r.DNSName != "":
+		value = r.DNSName
+	case r.PublicIPv4 != "":
+		value = r.PublicIPv4
+	case r.PublicIPv6 != "":
+		value = r.PublicIPv6
+	}
+	return fmt.Sprintf("%s (%s)", value, r.Provider)
+}
+
+// ContentHash returns a stable hash of every field that describes
+// what the resource points at. It is independent of the order
+// providers return resources in, so two enumeration passes can be
+// diffed deterministically: a resource whose identity is unchanged
+// but whose ContentHash differs has moved (e.g. an A-record's target
+// IP changed).
+func (r *Resource) ContentHash() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%t|%s|%s|%s|%s",
+		r.Provider, r.Profile, r.ID, r.Public, r.DNSName, r.DNSTarget, r.PublicIPv4, r.PublicIPv6)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Resources is a collection of assets returned by a provider.
+type Resources struct {
+	Items []*Resource
+}
+
+// Append adds a single resource to the collection.
+func (r *Resources) Append(resource *Resource) {
+	r.Items = append(r.Items, resource)
+}
+
+// Merge merges another collection of resources into this one. other
+// may be nil, in which case Merge is a no-op.
+func (r *Resources) Merge(other *Resources) {
+	if other == nil {
+		return
+	}
+	r.Items = append(r.Items, other.Items...)
+}
@@ -0,0 +1,16 @@
+package schema
+
+import "testing"
+
+func TestResourceContentHash(t *testing.T) {
+	a := &Resource{Provider: "aws", Profile: "prod", DNSName: "api.example.com", PublicIPv4: "1.1.1.1", Public: true}
+	b := &Resource{Provider: "aws", Profile: "prod", DNSName: "api.example.com", PublicIPv4: "1.1.1.1", Public: true}
+	if a.ContentHash() != b.ContentHash() {
+		t.Error("identical resources should have the same content hash")
+	}
+
+	changed := &Resource{Provider: "aws", Profile: "prod", DNSName: "api.example.com", PublicIPv4: "9.9.9.9", Public: true}
+	if a.ContentHash() == changed.ContentHash() {
+		t.Error("a resource with a different target ip should have a different content hash")
+	}
+}